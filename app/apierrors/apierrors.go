@@ -0,0 +1,34 @@
+// Package apierrors classifies domain errors for transport layers,
+// replacing switch-on-sentinel logic in the HTTP/gRPC gateways with a
+// single MapError call. Domain code keeps returning (and wrapping with
+// fmt.Errorf("...: %w", ...)) the errors it already returns; this package
+// only adds typed markers so a transport handler can ask "is this a
+// NotFound?" instead of comparing against every known sentinel.
+package apierrors
+
+// NotFound is implemented by errors meaning the requested resource does
+// not exist, and should surface as HTTP 404 / gRPC NotFound.
+type NotFound interface {
+	NotFound() bool
+}
+
+// Conflict is implemented by errors meaning the request could not be
+// applied because of the resource's current state, and should surface as
+// HTTP 409 / gRPC AlreadyExists.
+type Conflict interface {
+	Conflict() bool
+}
+
+// InvalidInput is implemented by errors caused by a malformed or invalid
+// request, and should surface as HTTP 400 / gRPC InvalidArgument.
+type InvalidInput interface {
+	InvalidInput() bool
+}
+
+// PathViolation is implemented by errors about an account path that is
+// syntactically well-formed but violates a structural rule, such as an
+// unknown class. It surfaces as HTTP 422 / gRPC FailedPrecondition, a more
+// specific classification than the generic InvalidInput.
+type PathViolation interface {
+	PathViolation() bool
+}
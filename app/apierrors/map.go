@@ -0,0 +1,80 @@
+package apierrors
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Status is the transport-agnostic classification produced by MapError.
+type Status struct {
+	HTTPStatus int
+	GRPCCode   codes.Code
+	Message    string
+}
+
+var internalStatus = Status{
+	HTTPStatus: http.StatusInternalServerError,
+	GRPCCode:   codes.Internal,
+	Message:    "internal error",
+}
+
+// causer is satisfied by github.com/pkg/errors' wrapped errors, which
+// predate errors.Unwrap and don't implement it.
+type causer interface {
+	Cause() error
+}
+
+// MapError walks err's cause chain, following both errors.Unwrap and
+// github.com/pkg/errors' Causer, and returns the Status for the deepest
+// cause that implements one of NotFound, Conflict, PathViolation or
+// InvalidInput. This lets domain code wrap a typed sentinel with extra
+// context (fmt.Errorf("creating transaction: %w", apierrors.ErrAccountPathViolation))
+// without the transport layer losing track of how to classify it.
+// Errors with no typed cause map to an opaque 500 / Internal.
+func MapError(err error) Status {
+	status := internalStatus
+
+	for current := err; current != nil; current = unwrapOne(current) {
+		if s, ok := classify(current); ok {
+			status = s
+		}
+	}
+
+	return status
+}
+
+// classify checks current itself (not its wrapped causes) against the
+// typed interfaces, most specific first, since PathViolation implies
+// InvalidInput.
+func classify(current error) (Status, bool) {
+	switch e := current.(type) {
+	case PathViolation:
+		if e.PathViolation() {
+			return Status{http.StatusUnprocessableEntity, codes.FailedPrecondition, current.Error()}, true
+		}
+	case NotFound:
+		if e.NotFound() {
+			return Status{http.StatusNotFound, codes.NotFound, current.Error()}, true
+		}
+	case Conflict:
+		if e.Conflict() {
+			return Status{http.StatusConflict, codes.AlreadyExists, current.Error()}, true
+		}
+	case InvalidInput:
+		if e.InvalidInput() {
+			return Status{http.StatusBadRequest, codes.InvalidArgument, current.Error()}, true
+		}
+	}
+
+	return Status{}, false
+}
+
+func unwrapOne(err error) error {
+	if c, ok := err.(causer); ok {
+		return c.Cause()
+	}
+
+	return errors.Unwrap(err)
+}
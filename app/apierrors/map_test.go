@@ -0,0 +1,68 @@
+package apierrors_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/stone-co/the-amazing-ledger/app/apierrors"
+)
+
+// invalidInputWrapper lets a test build a chain with two distinct typed
+// causes at different depths, which none of the package's own sentinels do
+// on their own since each wraps a single untyped app.Err* sentinel.
+type invalidInputWrapper struct{ cause error }
+
+func (e invalidInputWrapper) Error() string    { return e.cause.Error() }
+func (e invalidInputWrapper) Unwrap() error    { return e.cause }
+func (invalidInputWrapper) InvalidInput() bool { return true }
+
+func TestMapError(t *testing.T) {
+	t.Run("should classify a wrapped sentinel via errors.Unwrap", func(t *testing.T) {
+		err := fmt.Errorf("creating transaction: %w", apierrors.ErrAccountPathViolation)
+
+		status := apierrors.MapError(err)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, status.HTTPStatus)
+		assert.Equal(t, codes.FailedPrecondition, status.GRPCCode)
+	})
+
+	t.Run("should classify a wrapped sentinel via pkg/errors Causer", func(t *testing.T) {
+		err := pkgerrors.Wrap(apierrors.ErrInvalidAccountStructure, "parsing account")
+
+		status := apierrors.MapError(err)
+
+		assert.Equal(t, http.StatusBadRequest, status.HTTPStatus)
+		assert.Equal(t, codes.InvalidArgument, status.GRPCCode)
+	})
+
+	t.Run("should pick the deepest typed cause in the chain", func(t *testing.T) {
+		// The outer error classifies as InvalidInput (400) on its own, but
+		// it wraps a PathViolation (422); MapError must keep walking past
+		// the first match and return the status of the deepest one.
+		err := invalidInputWrapper{cause: apierrors.ErrAccountPathViolation}
+
+		status := apierrors.MapError(err)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, status.HTTPStatus)
+		assert.Equal(t, codes.FailedPrecondition, status.GRPCCode)
+	})
+
+	t.Run("should default to internal error for untyped errors", func(t *testing.T) {
+		status := apierrors.MapError(errors.New("boom"))
+
+		assert.Equal(t, http.StatusInternalServerError, status.HTTPStatus)
+		assert.Equal(t, codes.Internal, status.GRPCCode)
+	})
+
+	t.Run("should still satisfy errors.Is against the original sentinel", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", apierrors.ErrInvalidAccountStructure)
+
+		assert.True(t, errors.Is(err, apierrors.ErrInvalidAccountStructure))
+	})
+}
@@ -0,0 +1,32 @@
+package apierrors
+
+import "github.com/stone-co/the-amazing-ledger/app"
+
+// invalidInput wraps a sentinel error to satisfy InvalidInput while
+// keeping errors.Is/errors.As working against the wrapped sentinel via
+// Unwrap.
+type invalidInput struct{ cause error }
+
+func (e invalidInput) Error() string    { return e.cause.Error() }
+func (e invalidInput) Unwrap() error    { return e.cause }
+func (invalidInput) InvalidInput() bool { return true }
+
+// pathViolation wraps a sentinel error to satisfy both PathViolation and
+// InvalidInput, since every path violation is also an invalid input.
+type pathViolation struct{ cause error }
+
+func (e pathViolation) Error() string     { return e.cause.Error() }
+func (e pathViolation) Unwrap() error     { return e.cause }
+func (pathViolation) InvalidInput() bool  { return true }
+func (pathViolation) PathViolation() bool { return true }
+
+// Wrapped sentinels. Domain code should return these instead of the raw
+// app.Err* values so MapError can classify them without string-matching;
+// errors.Is against the original app.Err* sentinel still works.
+var (
+	ErrInvalidAccountStructure                 = invalidInput{app.ErrInvalidAccountStructure}
+	ErrInvalidAccountComponentSize             = invalidInput{app.ErrInvalidAccountComponentSize}
+	ErrInvalidAccountComponentCharacters       = invalidInput{app.ErrInvalidAccountComponentCharacters}
+	ErrInvalidSingleAccountComponentCharacters = invalidInput{app.ErrInvalidSingleAccountComponentCharacters}
+	ErrAccountPathViolation                    = pathViolation{app.ErrAccountPathViolation}
+)
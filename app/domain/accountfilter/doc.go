@@ -0,0 +1,17 @@
+// Package accountfilter maintains an in-memory probabilistic index of
+// every analytic account path ever written, so that wildcard and
+// synthetic queries (GetSyntheticAccountBalance, GetSyntheticReport,
+// ListAccountEntries) can skip the Postgres `ltree` lookup entirely for
+// account prefixes that are known not to exist, instead of always paying
+// for a query that returns nothing. dsl.Evaluator is one such consumer: it
+// checks a wildcard source against the filter before calling
+// GetSyntheticAccountBalance.
+//
+// The Postgres repository is expected to call Insert on every account
+// touched by CreateTransaction, call Delete when an account is removed
+// (accounts are not deleted today, but the hook exists for symmetry and
+// for the multi-tenant bucket teardown path), and call Rebuild once at
+// startup by scanning every distinct account currently on disk. No such
+// repository exists in this source tree yet, so those hooks are not wired
+// up anywhere today.
+package accountfilter
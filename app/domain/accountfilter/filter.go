@@ -0,0 +1,118 @@
+package accountfilter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	cuckoo "github.com/seiflotfy/cuckoofilter"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+)
+
+// MaxFalsePositiveRate is the observed false-positive rate above which
+// Filter.MightExist stops trusting the filter and reports every candidate
+// as a possible match, forcing callers back onto a direct SQL lookup.
+const MaxFalsePositiveRate = 0.05
+
+// Filter is an in-memory Cuckoo filter of known analytic account paths,
+// guarded so that queries fall back to "might exist" whenever the filter
+// is cold or its measured false-positive rate crosses
+// MaxFalsePositiveRate.
+type Filter struct {
+	mu   sync.RWMutex
+	cf   *cuckoo.Filter
+	inst domain.Instrumentator
+
+	hits    uint64
+	queries uint64
+}
+
+// New creates a Filter with room for approximately capacity accounts.
+// The filter starts cold (empty) until Rebuild or Insert is called.
+func New(capacity uint, inst domain.Instrumentator) *Filter {
+	return &Filter{
+		cf:   cuckoo.NewFilter(capacity),
+		inst: inst,
+	}
+}
+
+// Rebuild discards the current filter contents and re-inserts every
+// account in accounts. It is meant to be called once at startup, after
+// scanning the distinct accounts already persisted.
+func (f *Filter) Rebuild(accounts []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cf = cuckoo.NewFilter(uint(len(accounts)))
+	for _, account := range accounts {
+		f.cf.InsertUnique([]byte(account))
+	}
+}
+
+// Insert adds account to the filter. Safe to call concurrently.
+func (f *Filter) Insert(account string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cf.InsertUnique([]byte(account))
+}
+
+// Delete removes account from the filter. Safe to call concurrently.
+func (f *Filter) Delete(account string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cf.Delete([]byte(account))
+}
+
+// cold reports whether the filter has never been populated, in which case
+// every candidate must be treated as a possible match.
+func (f *Filter) cold() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.cf.Count() == 0
+}
+
+// MightExist reports whether account could be a known account. A false
+// return value guarantees the account was never inserted; a true return
+// value does not guarantee it exists. When the filter is cold, or its
+// measured false-positive rate exceeds MaxFalsePositiveRate, MightExist
+// always returns true so callers fall back to a direct SQL lookup.
+func (f *Filter) MightExist(ctx context.Context, account string) bool {
+	if f.cold() || f.falsePositiveRate() > MaxFalsePositiveRate {
+		return true
+	}
+
+	f.mu.RLock()
+	present := f.cf.Lookup([]byte(account))
+	f.mu.RUnlock()
+
+	return present
+}
+
+// ObserveQueryResult records whether a candidate that MightExist reported
+// as present actually matched an account in Postgres, so the running
+// false-positive rate can be computed and reported. It should be called
+// once per candidate after the SQL lookup for it comes back.
+func (f *Filter) ObserveQueryResult(ctx context.Context, matched bool) {
+	atomic.AddUint64(&f.queries, 1)
+	if matched {
+		atomic.AddUint64(&f.hits, 1)
+	}
+
+	if f.inst != nil {
+		f.inst.RecordGauge(ctx, "account_filter.false_positive_rate", f.falsePositiveRate())
+	}
+}
+
+func (f *Filter) falsePositiveRate() float64 {
+	queries := atomic.LoadUint64(&f.queries)
+	if queries == 0 {
+		return 0
+	}
+
+	hits := atomic.LoadUint64(&f.hits)
+	return 1 - float64(hits)/float64(queries)
+}
@@ -0,0 +1,51 @@
+package accountfilter
+
+import (
+	"strings"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// Prefixes returns account and every ancestor prefix of it, split on '.'.
+// For example Prefixes("asset.account.treasury") returns
+// []string{"asset", "asset.account", "asset.account.treasury"}. Insert
+// hooks add every prefix, not just the full account path, so that
+// CandidatePrefix lookups against synthetic patterns can be served by the
+// filter instead of always falling through to Postgres.
+func Prefixes(account string) []string {
+	labels := strings.Split(account, ".")
+	prefixes := make([]string, 0, len(labels))
+
+	for i := range labels {
+		prefixes = append(prefixes, strings.Join(labels[:i+1], "."))
+	}
+
+	return prefixes
+}
+
+// CandidatePrefix returns the longest leading run of labels in a synthetic
+// account's pattern that contains no wildcard, joined back with '.'. ok is
+// false when the pattern starts with a wildcard label, or when account is
+// not a synthetic (wildcard) account, in which case the filter has
+// nothing useful to check and the caller must fall back to a direct SQL
+// lookup.
+func CandidatePrefix(account vos.Account) (prefix string, ok bool) {
+	if account.Type() != vos.Synthetic {
+		return "", false
+	}
+
+	labels := strings.Split(account.Value(), ".")
+	if labels[0] == "*" {
+		return "", false
+	}
+
+	end := len(labels)
+	for i, label := range labels {
+		if label == "*" {
+			end = i
+			break
+		}
+	}
+
+	return strings.Join(labels[:end], "."), true
+}
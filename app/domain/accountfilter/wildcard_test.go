@@ -0,0 +1,43 @@
+package accountfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/accountfilter"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestPrefixes(t *testing.T) {
+	got := accountfilter.Prefixes("asset.account.treasury")
+
+	assert.Equal(t, []string{"asset", "asset.account", "asset.account.treasury"}, got)
+}
+
+func TestCandidatePrefix(t *testing.T) {
+	t.Run("should return the literal run before the first wildcard", func(t *testing.T) {
+		account, err := vos.NewAccount("asset.account.*")
+		assert.NoError(t, err)
+
+		prefix, ok := accountfilter.CandidatePrefix(account)
+		assert.True(t, ok)
+		assert.Equal(t, "asset.account", prefix)
+	})
+
+	t.Run("should report no usable prefix when the pattern starts with a wildcard", func(t *testing.T) {
+		account, err := vos.NewAccount("asset.*.treasury")
+		assert.NoError(t, err)
+
+		_, ok := accountfilter.CandidatePrefix(account)
+		assert.False(t, ok)
+	})
+
+	t.Run("should report no usable prefix for analytic accounts", func(t *testing.T) {
+		account, err := vos.NewAnalyticAccount("asset.account.treasury")
+		assert.NoError(t, err)
+
+		_, ok := accountfilter.CandidatePrefix(account)
+		assert.False(t, ok)
+	})
+}
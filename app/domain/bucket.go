@@ -0,0 +1,14 @@
+package domain
+
+// DefaultBucket is the tenant bucket used whenever a caller does not
+// specify one, so existing single-tenant callers keep working unchanged.
+const DefaultBucket = "default"
+
+// NormalizeBucket returns bucket, or DefaultBucket when bucket is empty.
+func NormalizeBucket(bucket string) string {
+	if bucket == "" {
+		return DefaultBucket
+	}
+
+	return bucket
+}
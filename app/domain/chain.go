@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/chainhash"
+)
+
+// ChainVerification reports whether a single persisted entry's hash still
+// matches the hash recomputed from its canonical bytes and the previous
+// entry's hash, as produced by the chainhash package. A mismatch means
+// either this entry or some entry before it in the chain was altered
+// after being committed.
+type ChainVerification struct {
+	EntryID      uuid.UUID
+	PreviousHash []byte
+	StoredHash   []byte
+	ComputedHash []byte
+	Valid        bool
+}
+
+// VerifyEntries recomputes an account's hash chain via chainhash.Verify and
+// zips the result against entryIDs, in the same chain order, to produce the
+// []ChainVerification that Repository.VerifyChain promises. The caller (the
+// Postgres repository) is responsible for loading entries in the requested
+// range, in chain order, and for the hash of the entry immediately
+// preceding the range (Genesis if the range starts at the account's first
+// entry).
+func VerifyEntries(previousHash []byte, entryIDs []uuid.UUID, entries []chainhash.ChainedEntry) ([]ChainVerification, error) {
+	if len(entryIDs) != len(entries) {
+		return nil, fmt.Errorf("domain: %d entry ids for %d entries", len(entryIDs), len(entries))
+	}
+
+	results := chainhash.Verify(previousHash, entries)
+
+	verifications := make([]ChainVerification, len(results))
+	for i, result := range results {
+		verifications[i] = ChainVerification{
+			EntryID:      entryIDs[i],
+			PreviousHash: result.PreviousHash,
+			StoredHash:   entries[i].StoredHash,
+			ComputedHash: result.ComputedHash,
+			Valid:        result.Valid,
+		}
+	}
+
+	return verifications, nil
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/chainhash"
+)
+
+func TestVerifyEntries(t *testing.T) {
+	t.Run("should attach entry ids to the recomputed chain in order", func(t *testing.T) {
+		canonical := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, time.Now(), nil)
+		hash := chainhash.Next(chainhash.Genesis, canonical)
+
+		id := uuid.New()
+		verifications, err := VerifyEntries(chainhash.Genesis, []uuid.UUID{id}, []chainhash.ChainedEntry{
+			{CanonicalBytes: canonical, StoredHash: hash},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, verifications, 1)
+		assert.Equal(t, id, verifications[0].EntryID)
+		assert.True(t, verifications[0].Valid)
+	})
+
+	t.Run("should error when entryIDs and entries don't line up", func(t *testing.T) {
+		_, err := VerifyEntries(chainhash.Genesis, []uuid.UUID{uuid.New(), uuid.New()}, nil)
+		assert.Error(t, err)
+	})
+}
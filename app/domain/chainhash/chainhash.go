@@ -0,0 +1,107 @@
+// Package chainhash implements the canonical serialization and hash
+// chaining used to make each account's entry log tamper-evident: every
+// persisted entry stores the hash of its canonical bytes chained onto the
+// hash of the entry committed before it for the same account, so altering
+// or removing an entry breaks every hash computed after it.
+package chainhash
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Genesis is the previous hash used for the first entry of an account's
+// chain.
+var Genesis = make([]byte, sha256.Size)
+
+// CanonicalBytes serializes the fields that make up an entry's identity, in
+// a fixed order, so the same entry always hashes to the same value
+// regardless of how it was constructed. Metadata keys are sorted so that
+// map iteration order never affects the result. amount is serialized via
+// its decimal string form (rather than a fixed-width integer encoding) so
+// arbitrarily large amounts hash the same regardless of *big.Int's
+// internal representation.
+func CanonicalBytes(account, operation, asset string, amount *big.Int, event int32, competenceDate time.Time, metadata map[string]string) []byte {
+	var buf []byte
+
+	buf = append(buf, []byte(account)...)
+	buf = append(buf, 0)
+	buf = append(buf, []byte(operation)...)
+	buf = append(buf, 0)
+	buf = append(buf, []byte(asset)...)
+	buf = append(buf, 0)
+	buf = append(buf, []byte(amount.String())...)
+	buf = append(buf, 0)
+
+	var eventBytes [4]byte
+	binary.BigEndian.PutUint32(eventBytes[:], uint32(event))
+	buf = append(buf, eventBytes[:]...)
+
+	buf = append(buf, []byte(competenceDate.UTC().Format(time.RFC3339Nano))...)
+	buf = append(buf, 0)
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		buf = append(buf, []byte(k)...)
+		buf = append(buf, '=')
+		buf = append(buf, []byte(metadata[k])...)
+		buf = append(buf, 0)
+	}
+
+	return buf
+}
+
+// Next computes the hash of an entry given the previous entry's hash (or
+// Genesis, for the first entry of a chain) and that entry's canonical
+// bytes: hash = SHA-256(previousHash || canonicalBytes).
+func Next(previousHash, canonicalBytes []byte) []byte {
+	h := sha256.New()
+	h.Write(previousHash)
+	h.Write(canonicalBytes)
+	return h.Sum(nil)
+}
+
+// ChainedEntry is the subset of a persisted entry's fields needed to
+// recompute its place in an account's hash chain: its canonical bytes (as
+// produced by CanonicalBytes) and the hash that was actually stored for it.
+type ChainedEntry struct {
+	CanonicalBytes []byte
+	StoredHash     []byte
+}
+
+// VerificationResult is one entry's outcome from Verify.
+type VerificationResult struct {
+	PreviousHash []byte
+	ComputedHash []byte
+	Valid        bool
+}
+
+// Verify recomputes the hash chain over entries, in chain order, starting
+// from previousHash (Genesis for the first entry ever written for the
+// account), and returns one VerificationResult per entry. Because each
+// computed hash chains onto the one before it, altering, removing, or
+// reordering any entry makes every result after it invalid too, not just
+// the tampered entry itself.
+func Verify(previousHash []byte, entries []ChainedEntry) []VerificationResult {
+	results := make([]VerificationResult, len(entries))
+
+	for i, entry := range entries {
+		computed := Next(previousHash, entry.CanonicalBytes)
+		results[i] = VerificationResult{
+			PreviousHash: previousHash,
+			ComputedHash: computed,
+			Valid:        bytes.Equal(computed, entry.StoredHash),
+		}
+		previousHash = computed
+	}
+
+	return results
+}
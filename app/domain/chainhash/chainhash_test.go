@@ -0,0 +1,90 @@
+package chainhash_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/chainhash"
+)
+
+func TestCanonicalBytes(t *testing.T) {
+	competenceDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should be deterministic", func(t *testing.T) {
+		a := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, map[string]string{"order_id": "1"})
+		b := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, map[string]string{"order_id": "1"})
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("should be insensitive to metadata key order", func(t *testing.T) {
+		a := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, map[string]string{"a": "1", "b": "2"})
+		b := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, map[string]string{"b": "2", "a": "1"})
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("should change when the amount changes", func(t *testing.T) {
+		base := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, nil)
+		other := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(101), 1, competenceDate, nil)
+
+		assert.NotEqual(t, base, other)
+	})
+
+	t.Run("should change when the asset changes", func(t *testing.T) {
+		base := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, competenceDate, nil)
+		other := chainhash.CanonicalBytes("asset.account.x", "debit", "BTC", big.NewInt(100), 1, competenceDate, nil)
+
+		assert.NotEqual(t, base, other)
+	})
+}
+
+func TestNext(t *testing.T) {
+	t.Run("should chain onto the previous hash", func(t *testing.T) {
+		entry := chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(100), 1, time.Now(), nil)
+
+		first := chainhash.Next(chainhash.Genesis, entry)
+		second := chainhash.Next(first, entry)
+
+		assert.NotEqual(t, first, second)
+		assert.Equal(t, first, chainhash.Next(chainhash.Genesis, entry))
+	})
+}
+
+func TestVerify(t *testing.T) {
+	competenceDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	bytesFor := func(amount int64) []byte {
+		return chainhash.CanonicalBytes("asset.account.x", "debit", "BRL", big.NewInt(amount), 1, competenceDate, nil)
+	}
+
+	t.Run("should validate an untampered chain", func(t *testing.T) {
+		first := chainhash.Next(chainhash.Genesis, bytesFor(100))
+		second := chainhash.Next(first, bytesFor(200))
+
+		results := chainhash.Verify(chainhash.Genesis, []chainhash.ChainedEntry{
+			{CanonicalBytes: bytesFor(100), StoredHash: first},
+			{CanonicalBytes: bytesFor(200), StoredHash: second},
+		})
+
+		assert.Len(t, results, 2)
+		assert.True(t, results[0].Valid)
+		assert.True(t, results[1].Valid)
+		assert.Equal(t, second, results[1].ComputedHash)
+	})
+
+	t.Run("should invalidate every entry from the tampered one onward", func(t *testing.T) {
+		first := chainhash.Next(chainhash.Genesis, bytesFor(100))
+		second := chainhash.Next(first, bytesFor(200))
+
+		results := chainhash.Verify(chainhash.Genesis, []chainhash.ChainedEntry{
+			{CanonicalBytes: bytesFor(999), StoredHash: first}, // amount tampered after the fact
+			{CanonicalBytes: bytesFor(200), StoredHash: second},
+		})
+
+		assert.False(t, results[0].Valid)
+		assert.False(t, results[1].Valid)
+	})
+}
@@ -0,0 +1,52 @@
+package dsl
+
+import "math/big"
+
+// Script is the parsed representation of a DSL program. The current
+// grammar supports exactly one `send` statement per script; this mirrors
+// the scope of the first version of the language and may grow to support
+// sequences of statements later.
+type Script struct {
+	Send SendStatement
+}
+
+// SendStatement describes the movement of a fixed amount of a single asset
+// from a set of sources to a set of destinations.
+type SendStatement struct {
+	Asset       string
+	Amount      *big.Int
+	Sources     []SourceClause
+	Destination []DestinationClause
+}
+
+// SourceClause is one entry of a `source` block. Exactly one of Max or
+// Remaining is set: a Max clause caps how much can be drawn from Account,
+// while a Remaining clause (IsRemaining == true) drains whatever is left
+// of the amount being sent.
+type SourceClause struct {
+	Account     AccountRef
+	Max         *big.Int
+	IsRemaining bool
+}
+
+// DestinationClause is one entry of a `destination` block. A clause either
+// allocates a fixed Portion (0 < Portion <= 1) of the sent amount, or, when
+// IsRemaining is true, receives whatever portion was not claimed by the
+// preceding clauses.
+type DestinationClause struct {
+	Account     AccountRef
+	Portion     float64
+	IsRemaining bool
+}
+
+// AccountRef is either a literal account path (`@account.x`) or a variable
+// name (`@source_account`) to be resolved against the variables map
+// supplied to Evaluate.
+type AccountRef struct {
+	Literal  string
+	Variable string
+}
+
+func (r AccountRef) isVariable() bool {
+	return r.Variable != ""
+}
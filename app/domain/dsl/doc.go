@@ -0,0 +1,26 @@
+// Package dsl implements a small money-movement script language, inspired by
+// ledger scripting languages such as Numscript, that compiles down to an
+// entities.Transaction accepted by domain.Repository.CreateTransaction.
+//
+// A script describes a single `send` statement:
+//
+//	send [USD 1000] (
+//	  source = {
+//	    max [USD 600] from @account.treasury.a
+//	    max [USD 400] from @account.treasury.b
+//	    remaining from @account.treasury.overflow
+//	  }
+//	  destination = {
+//	    50% to @account.clients.alice
+//	    remaining to @account.clients.bob
+//	  }
+//	)
+//
+// `source` clauses are evaluated in order: each `max` clause draws up to its
+// cap from a single account, cascading to the next clause once exhausted,
+// and a trailing `remaining` clause absorbs whatever is left. `destination`
+// clauses allocate the sent amount by fixed portions (e.g. `50%`) with a
+// trailing `remaining` clause receiving whatever was not otherwise
+// allocated. Account references (`@account.x`) may also be plain variables
+// (`@source_account`) resolved from the variables map passed to Evaluate.
+package dsl
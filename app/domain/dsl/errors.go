@@ -0,0 +1,30 @@
+package dsl
+
+import "errors"
+
+var (
+	// ErrSyntax is returned by Parse when the script does not match the
+	// DSL grammar. The returned error always wraps ErrSyntax so callers
+	// can distinguish parse failures from evaluation failures with
+	// errors.Is.
+	ErrSyntax = errors.New("dsl: syntax error")
+
+	// ErrUnknownVariable is returned when an account reference points to a
+	// variable that is missing from the variables map passed to Evaluate.
+	ErrUnknownVariable = errors.New("dsl: unknown variable")
+
+	// ErrInvalidPortions is returned when the sum of fixed destination
+	// portions exceeds 100%, or when more than one `remaining` clause is
+	// declared in the same block.
+	ErrInvalidPortions = errors.New("dsl: invalid destination portions")
+
+	// ErrInsufficientFunds is returned when the source clauses, evaluated
+	// against current balances, cannot cover the amount being sent.
+	ErrInsufficientFunds = errors.New("dsl: insufficient funds")
+
+	// ErrUnbalancedTransaction is returned when the compiled transaction
+	// does not balance (sum of debits != sum of credits) for some asset.
+	// This should only happen if there is a bug in the evaluator, since
+	// the evaluator is responsible for producing balanced entries.
+	ErrUnbalancedTransaction = errors.New("dsl: unbalanced transaction")
+)
@@ -0,0 +1,288 @@
+package dsl
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/accountfilter"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// Plan is the result of evaluating a Script: a balanced set of entries
+// together with the total amount moved. It is returned as-is in dry-run
+// mode, or compiled into an entities.Transaction and persisted otherwise.
+type Plan struct {
+	Bucket  string
+	Asset   string
+	Amount  *big.Int
+	Entries []entities.Entry
+}
+
+// Evaluator resolves a parsed Script against the ledger's current state,
+// rejecting overdraws and producing a balanced double-entry Plan.
+type Evaluator struct {
+	repo   domain.Repository
+	filter *accountfilter.Filter
+}
+
+// NewEvaluator creates an Evaluator backed by the given Repository, used
+// to validate account balances while resolving source clauses. A Filter may
+// optionally be given so that a synthetic (wildcard) source known not to
+// exist is rejected without issuing a GetSyntheticAccountBalance query;
+// passing more than one filter is a programmer error and only the first is
+// used.
+func NewEvaluator(repo domain.Repository, filter ...*accountfilter.Filter) *Evaluator {
+	return &Evaluator{repo: repo, filter: firstFilter(filter)}
+}
+
+func firstFilter(filters []*accountfilter.Filter) *accountfilter.Filter {
+	if len(filters) > 0 {
+		return filters[0]
+	}
+	return nil
+}
+
+// Evaluate resolves script against variables and returns a balanced Plan.
+// Wildcard sources are resolved against vos.NewAccount, and balances are
+// checked via GetAnalyticAccountBalance/GetSyntheticAccountBalance to
+// reject overdraws before any entry is produced. An empty bucket resolves
+// to domain.DefaultBucket.
+func (e *Evaluator) Evaluate(ctx context.Context, script Script, variables map[string]string, bucket string) (Plan, error) {
+	bucket = domain.NormalizeBucket(bucket)
+	send := script.Send
+
+	sourceEntries, err := e.resolveSources(ctx, send, variables, bucket)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	destinationEntries, err := e.resolveDestinations(send, variables)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	entries := make([]entities.Entry, 0, len(sourceEntries)+len(destinationEntries))
+	entries = append(entries, sourceEntries...)
+	entries = append(entries, destinationEntries...)
+
+	if err := verifyBalanced(send.Asset, entries); err != nil {
+		return Plan{}, err
+	}
+
+	return Plan{Bucket: bucket, Asset: send.Asset, Amount: send.Amount, Entries: entries}, nil
+}
+
+func (e *Evaluator) resolveSources(ctx context.Context, send SendStatement, variables map[string]string, bucket string) ([]entities.Entry, error) {
+	remaining := new(big.Int).Set(send.Amount)
+	entries := make([]entities.Entry, 0, len(send.Sources))
+
+	for _, clause := range send.Sources {
+		if remaining.Sign() <= 0 {
+			break
+		}
+
+		account, err := resolveAccount(clause.Account, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		draw := remaining
+		if !clause.IsRemaining && clause.Max.Cmp(draw) < 0 {
+			draw = clause.Max
+		}
+		if draw.Sign() <= 0 {
+			continue
+		}
+
+		if err := e.checkFunds(ctx, bucket, send.Asset, account, draw); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entities.Entry{
+			Account:   account,
+			Asset:     send.Asset,
+			Operation: vos.DebitOperation,
+			Amount:    draw,
+			Event:     int32(new(big.Int).Sub(send.Amount, remaining).Int64()),
+		})
+		remaining = new(big.Int).Sub(remaining, draw)
+	}
+
+	if remaining.Sign() > 0 {
+		return nil, fmt.Errorf("%w: %s %s short across declared sources", ErrInsufficientFunds, remaining, send.Asset)
+	}
+
+	return entries, nil
+}
+
+func (e *Evaluator) checkFunds(ctx context.Context, bucket, asset string, account vos.Account, amount *big.Int) error {
+	var (
+		balances []vos.AccountBalance
+		err      error
+	)
+
+	if account.Type() == vos.Synthetic {
+		if e.knownAbsent(ctx, account) {
+			return fmt.Errorf("%w: %q matches no known account", ErrInsufficientFunds, account.Value())
+		}
+		balances, err = e.repo.GetSyntheticAccountBalance(ctx, bucket, account)
+		if err == nil && e.filter != nil {
+			e.filter.ObserveQueryResult(ctx, len(balances) > 0)
+		}
+	} else {
+		balances, err = e.repo.GetAnalyticAccountBalance(ctx, bucket, account)
+	}
+	if err != nil {
+		return fmt.Errorf("checking balance of %q: %w", account.Value(), err)
+	}
+
+	balance := balanceOf(balances, asset)
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: %q has %s %s, needs %s", ErrInsufficientFunds, account.Value(), balance, asset, amount)
+	}
+
+	return nil
+}
+
+// knownAbsent reports whether account's wildcard pattern is, per the
+// account filter, guaranteed not to match any account ever written, so the
+// GetSyntheticAccountBalance query can be skipped entirely. It always
+// returns false when no filter was given to NewEvaluator, or when
+// accountfilter.CandidatePrefix can't derive a prefix to check (e.g. the
+// pattern starts with a wildcard label).
+func (e *Evaluator) knownAbsent(ctx context.Context, account vos.Account) bool {
+	if e.filter == nil {
+		return false
+	}
+
+	prefix, ok := accountfilter.CandidatePrefix(account)
+	if !ok {
+		return false
+	}
+
+	return !e.filter.MightExist(ctx, prefix)
+}
+
+// balanceOf returns the balance for asset out of balances, or zero when
+// the account has never moved that asset.
+func balanceOf(balances []vos.AccountBalance, asset string) *big.Int {
+	for _, b := range balances {
+		if b.Asset.Code() == asset {
+			return b.Balance
+		}
+	}
+
+	return big.NewInt(0)
+}
+
+func (e *Evaluator) resolveDestinations(send SendStatement, variables map[string]string) ([]entities.Entry, error) {
+	var fixedTotal float64
+	remainingClauses := 0
+	for _, clause := range send.Destination {
+		if clause.IsRemaining {
+			remainingClauses++
+			continue
+		}
+		fixedTotal += clause.Portion
+	}
+	if fixedTotal > 1.0001 || remainingClauses > 1 {
+		return nil, ErrInvalidPortions
+	}
+
+	entries := make([]entities.Entry, 0, len(send.Destination))
+	allocated := big.NewInt(0)
+
+	for _, clause := range send.Destination {
+		account, err := resolveAccount(clause.Account, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		var amount *big.Int
+		if clause.IsRemaining {
+			// Only a clause explicitly declared `remaining` absorbs
+			// whatever the fixed clauses didn't claim; a fixed clause
+			// always gets exactly its own portion, so a destination
+			// block with no `remaining` clause still has to add up to
+			// send.Amount on its own, and the check below can fail.
+			amount = new(big.Int).Sub(send.Amount, allocated)
+		} else {
+			amount = portionAmount(send.Amount, clause.Portion)
+		}
+
+		entries = append(entries, entities.Entry{
+			Account:   account,
+			Asset:     send.Asset,
+			Operation: vos.CreditOperation,
+			Amount:    amount,
+			Event:     int32(allocated.Int64()),
+		})
+		allocated = new(big.Int).Add(allocated, amount)
+	}
+
+	if allocated.Cmp(send.Amount) != 0 {
+		return nil, fmt.Errorf("%w: allocated %s, expected %s", ErrInvalidPortions, allocated, send.Amount)
+	}
+
+	return entries, nil
+}
+
+// portionAmount computes total*portion using rational arithmetic, rather
+// than float64 multiplication, so large amounts don't drift away from the
+// exact fraction the script asked for.
+func portionAmount(total *big.Int, portion float64) *big.Int {
+	rat := new(big.Rat).SetFloat64(portion)
+	rat.Mul(rat, new(big.Rat).SetInt(total))
+
+	return new(big.Int).Quo(rat.Num(), rat.Denom())
+}
+
+func resolveAccount(ref AccountRef, variables map[string]string) (vos.Account, error) {
+	path := ref.Literal
+	if ref.isVariable() {
+		value, ok := variables[ref.Variable]
+		if !ok {
+			return vos.Account{}, fmt.Errorf("%w: %q", ErrUnknownVariable, ref.Variable)
+		}
+		path = value
+	}
+
+	return vos.NewAccount(path)
+}
+
+func verifyBalanced(asset string, entries []entities.Entry) error {
+	debits, credits := big.NewInt(0), big.NewInt(0)
+	for _, entry := range entries {
+		switch entry.Operation {
+		case vos.DebitOperation:
+			debits.Add(debits, entry.Amount)
+		case vos.CreditOperation:
+			credits.Add(credits, entry.Amount)
+		}
+	}
+
+	if debits.Cmp(credits) != 0 {
+		return fmt.Errorf("%w: %s debits=%s credits=%s", ErrUnbalancedTransaction, asset, debits, credits)
+	}
+
+	return nil
+}
+
+// Compile turns a Plan into an entities.Transaction ready to be persisted
+// via domain.Repository.CreateTransaction. idempotencyKey is carried
+// through unchanged so a retried CreateTransaction call with the same key
+// returns the transaction originally committed for it instead of creating
+// a duplicate.
+func Compile(plan Plan, idempotencyKey uuid.UUID) entities.Transaction {
+	return entities.Transaction{
+		ID:             uuid.New(),
+		Bucket:         plan.Bucket,
+		IdempotencyKey: idempotencyKey,
+		Entries:        plan.Entries,
+	}
+}
@@ -0,0 +1,231 @@
+package dsl_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/accountfilter"
+	"github.com/stone-co/the-amazing-ledger/app/domain/dsl"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func usdBalance(amount int64) []vos.AccountBalance {
+	usd, err := vos.NewAsset("USD", 2)
+	if err != nil {
+		panic(err)
+	}
+
+	return []vos.AccountBalance{{Asset: usd, Balance: big.NewInt(amount)}}
+}
+
+// gaugeRecorder is a minimal domain.Instrumentator that only counts
+// RecordGauge calls, for asserting that accountfilter.Filter was actually
+// told about a query result.
+type gaugeRecorder struct {
+	calls int
+}
+
+func (g *gaugeRecorder) Log(ctx context.Context, value string)             {}
+func (g *gaugeRecorder) MonitorSegment(ctx context.Context) domain.Segment { return noopSegment{} }
+func (g *gaugeRecorder) MonitorDataSegment(ctx context.Context, collection, operation, query string) domain.Segment {
+	return noopSegment{}
+}
+func (g *gaugeRecorder) RecordGauge(ctx context.Context, name string, value float64) {
+	g.calls++
+}
+
+type noopSegment struct{}
+
+func (noopSegment) End() {}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	t.Run("should split a send across sources and destinations", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 1000] (
+				source = {
+					max [USD 600] from @asset.account.a
+					remaining from @asset.account.b
+				}
+				destination = {
+					50% to @liability.available.alice
+					remaining to @liability.available.bob
+				}
+			)
+		`)
+		assert.NoError(t, err)
+
+		repo := &mocks.RepositoryMock{
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error) {
+				return usdBalance(1000), nil
+			},
+		}
+
+		plan, err := dsl.NewEvaluator(repo).Evaluate(context.Background(), script, nil, "")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "USD", plan.Asset)
+		assert.Equal(t, big.NewInt(1000), plan.Amount)
+		assert.Len(t, plan.Entries, 4)
+	})
+
+	t.Run("should reject a send that overdraws a source", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 1000] (
+				source = @asset.account.a
+				destination = @liability.available.alice
+			)
+		`)
+		assert.NoError(t, err)
+
+		repo := &mocks.RepositoryMock{
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error) {
+				return usdBalance(1), nil
+			},
+		}
+
+		_, err = dsl.NewEvaluator(repo).Evaluate(context.Background(), script, nil, "")
+		assert.ErrorIs(t, err, dsl.ErrInsufficientFunds)
+	})
+
+	t.Run("should resolve variable account references", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 100] (
+				source = @source_account
+				destination = @destination_account
+			)
+		`)
+		assert.NoError(t, err)
+
+		repo := &mocks.RepositoryMock{
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error) {
+				return usdBalance(100), nil
+			},
+		}
+
+		variables := map[string]string{
+			"source_account":      "asset.account.a",
+			"destination_account": "liability.available.alice",
+		}
+
+		plan, err := dsl.NewEvaluator(repo).Evaluate(context.Background(), script, variables, "")
+		assert.NoError(t, err)
+		assert.Len(t, plan.Entries, 2)
+	})
+
+	t.Run("should reject fixed destination portions that don't cover the full amount", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 1000] (
+				source = @asset.account.a
+				destination = {
+					10% to @liability.available.alice
+					10% to @liability.available.bob
+				}
+			)
+		`)
+		assert.NoError(t, err)
+
+		repo := &mocks.RepositoryMock{
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error) {
+				return usdBalance(1000), nil
+			},
+		}
+
+		_, err = dsl.NewEvaluator(repo).Evaluate(context.Background(), script, nil, "")
+		assert.ErrorIs(t, err, dsl.ErrInvalidPortions)
+	})
+
+	t.Run("should reject a wildcard source the account filter knows is absent without querying the repository", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 100] (
+				source = @asset.ghost.*
+				destination = @liability.available.alice
+			)
+		`)
+		assert.NoError(t, err)
+
+		filter := accountfilter.New(8, nil)
+		filter.Rebuild(accountfilter.Prefixes("asset.account.a"))
+
+		repo := &mocks.RepositoryMock{}
+
+		_, err = dsl.NewEvaluator(repo, filter).Evaluate(context.Background(), script, nil, "")
+		assert.ErrorIs(t, err, dsl.ErrInsufficientFunds)
+	})
+
+	t.Run("should report a synthetic balance lookup back to the account filter", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 100] (
+				source = @asset.ghost.*
+				destination = @liability.available.alice
+			)
+		`)
+		assert.NoError(t, err)
+
+		inst := &gaugeRecorder{}
+		filter := accountfilter.New(8, inst)
+		filter.Rebuild(accountfilter.Prefixes("asset.ghost.real"))
+
+		repo := &mocks.RepositoryMock{
+			GetSyntheticAccountBalanceFunc: func(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error) {
+				return usdBalance(100), nil
+			},
+		}
+
+		_, err = dsl.NewEvaluator(repo, filter).Evaluate(context.Background(), script, nil, "")
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, inst.calls)
+	})
+
+	t.Run("should return ErrUnknownVariable when a variable is missing", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 100] (
+				source = @missing_var
+				destination = @liability.available.alice
+			)
+		`)
+		assert.NoError(t, err)
+
+		_, err = dsl.NewEvaluator(&mocks.RepositoryMock{}).Evaluate(context.Background(), script, nil, "")
+		assert.ErrorIs(t, err, dsl.ErrUnknownVariable)
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("should return ErrSyntax for malformed input", func(t *testing.T) {
+		_, err := dsl.Parse(`send [USD] ( source = @a destination = @b )`)
+		assert.ErrorIs(t, err, dsl.ErrSyntax)
+	})
+
+	t.Run("should parse a trailing wildcard label in an account reference", func(t *testing.T) {
+		script, err := dsl.Parse(`
+			send [USD 100] (
+				source = @asset.ghost.*
+				destination = @liability.available.alice
+			)
+		`)
+		assert.NoError(t, err)
+		assert.Equal(t, "asset.ghost.*", script.Send.Sources[0].Account.Literal)
+	})
+
+	t.Run("should parse a multi-clause source/destination block", func(t *testing.T) {
+		_, err := dsl.Parse(`
+			send [USD 1000] (
+				source = {
+					max [USD 600] from @asset.account.a
+					remaining from @asset.account.b
+				}
+				destination = {
+					50% to @liability.available.alice
+					remaining to @liability.available.bob
+				}
+			)
+		`)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,327 @@
+package dsl
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Parse parses a DSL script into a Script. It returns an error wrapping
+// ErrSyntax when the input does not match the grammar.
+func Parse(source string) (Script, error) {
+	p := &parser{tokens: tokenize(source)}
+
+	send, err := p.parseSend()
+	if err != nil {
+		return Script{}, err
+	}
+
+	if !p.atEnd() {
+		return Script{}, p.errorf("unexpected token %q after send statement", p.peek().text)
+	}
+
+	return Script{Send: send}, nil
+}
+
+type tokenKind uint8
+
+const (
+	tokenIdent tokenKind = iota
+	tokenNumber
+	tokenPercent
+	tokenAccountRef
+	tokenVariableRef
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(source string) []token {
+	var tokens []token
+
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '@':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '.' || runes[j] == '*') {
+				j++
+			}
+			name := string(runes[i+1 : j])
+			if strings.Contains(name, ".") {
+				tokens = append(tokens, token{kind: tokenAccountRef, text: name})
+			} else {
+				tokens = append(tokens, token{kind: tokenVariableRef, text: name})
+			}
+			i = j
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			num := string(runes[i:j])
+			if j < len(runes) && runes[j] == '%' {
+				tokens = append(tokens, token{kind: tokenPercent, text: num})
+				j++
+			} else {
+				tokens = append(tokens, token{kind: tokenNumber, text: num})
+			}
+			i = j
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[i:j])})
+			i = j
+		case strings.ContainsRune("[](){}=", r):
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(r)})
+			i++
+		default:
+			// Skip unknown characters rather than aborting tokenization;
+			// the parser surfaces a syntax error at the first token it
+			// cannot make sense of.
+			i++
+		}
+	}
+
+	return tokens
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) atEnd() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.atEnd() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrSyntax}, args...)...)
+}
+
+func (p *parser) expectIdent(text string) error {
+	t := p.next()
+	if t.kind != tokenIdent || t.text != text {
+		return p.errorf("expected %q, got %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *parser) parseBigInt(t token) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(t.text, 10)
+	if !ok {
+		return nil, p.errorf("invalid amount %q", t.text)
+	}
+	return amount, nil
+}
+
+func (p *parser) expectSymbol(text string) error {
+	t := p.next()
+	if t.kind != tokenSymbol || t.text != text {
+		return p.errorf("expected %q, got %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *parser) parseSend() (SendStatement, error) {
+	if err := p.expectIdent("send"); err != nil {
+		return SendStatement{}, err
+	}
+	if err := p.expectSymbol("["); err != nil {
+		return SendStatement{}, err
+	}
+
+	asset := p.next()
+	if asset.kind != tokenIdent {
+		return SendStatement{}, p.errorf("expected asset code, got %q", asset.text)
+	}
+
+	amountTok := p.next()
+	if amountTok.kind != tokenNumber {
+		return SendStatement{}, p.errorf("expected amount, got %q", amountTok.text)
+	}
+	amount, err := p.parseBigInt(amountTok)
+	if err != nil {
+		return SendStatement{}, err
+	}
+
+	if err := p.expectSymbol("]"); err != nil {
+		return SendStatement{}, err
+	}
+	if err := p.expectSymbol("("); err != nil {
+		return SendStatement{}, err
+	}
+
+	if err := p.expectIdent("source"); err != nil {
+		return SendStatement{}, err
+	}
+	if err := p.expectSymbol("="); err != nil {
+		return SendStatement{}, err
+	}
+	sources, err := p.parseSourceBlock()
+	if err != nil {
+		return SendStatement{}, err
+	}
+
+	if err := p.expectIdent("destination"); err != nil {
+		return SendStatement{}, err
+	}
+	if err := p.expectSymbol("="); err != nil {
+		return SendStatement{}, err
+	}
+	destinations, err := p.parseDestinationBlock()
+	if err != nil {
+		return SendStatement{}, err
+	}
+
+	if err := p.expectSymbol(")"); err != nil {
+		return SendStatement{}, err
+	}
+
+	return SendStatement{
+		Asset:       asset.text,
+		Amount:      amount,
+		Sources:     sources,
+		Destination: destinations,
+	}, nil
+}
+
+// parseSourceBlock parses either a single `@account.x` reference or a
+// `{ ... }` block of max/remaining clauses.
+func (p *parser) parseSourceBlock() ([]SourceClause, error) {
+	if ref, ok := p.tryParseAccountRef(); ok {
+		return []SourceClause{{Account: ref, IsRemaining: true}}, nil
+	}
+
+	if err := p.expectSymbol("{"); err != nil {
+		return nil, err
+	}
+
+	var clauses []SourceClause
+	for p.peek().text != "}" {
+		switch {
+		case p.peek().kind == tokenIdent && p.peek().text == "max":
+			p.next()
+			if err := p.expectSymbol("["); err != nil {
+				return nil, err
+			}
+			asset := p.next()
+			if asset.kind != tokenIdent {
+				return nil, p.errorf("expected asset code, got %q", asset.text)
+			}
+			amountTok := p.next()
+			amount, err := p.parseBigInt(amountTok)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectSymbol("]"); err != nil {
+				return nil, err
+			}
+			if err := p.expectIdent("from"); err != nil {
+				return nil, err
+			}
+			ref, ok := p.tryParseAccountRef()
+			if !ok {
+				return nil, p.errorf("expected account reference after 'from'")
+			}
+			clauses = append(clauses, SourceClause{Account: ref, Max: amount})
+		case p.peek().kind == tokenIdent && p.peek().text == "remaining":
+			p.next()
+			if err := p.expectIdent("from"); err != nil {
+				return nil, err
+			}
+			ref, ok := p.tryParseAccountRef()
+			if !ok {
+				return nil, p.errorf("expected account reference after 'from'")
+			}
+			clauses = append(clauses, SourceClause{Account: ref, IsRemaining: true})
+		default:
+			return nil, p.errorf("expected 'max' or 'remaining', got %q", p.peek().text)
+		}
+	}
+	p.next() // consume '}'
+
+	return clauses, nil
+}
+
+func (p *parser) parseDestinationBlock() ([]DestinationClause, error) {
+	if ref, ok := p.tryParseAccountRef(); ok {
+		return []DestinationClause{{Account: ref, IsRemaining: true}}, nil
+	}
+
+	if err := p.expectSymbol("{"); err != nil {
+		return nil, err
+	}
+
+	var clauses []DestinationClause
+	for p.peek().text != "}" {
+		switch {
+		case p.peek().kind == tokenPercent:
+			pct := p.next()
+			portion, err := strconv.ParseFloat(pct.text, 64)
+			if err != nil {
+				return nil, p.errorf("invalid portion %q: %v", pct.text, err)
+			}
+			if err := p.expectIdent("to"); err != nil {
+				return nil, err
+			}
+			ref, ok := p.tryParseAccountRef()
+			if !ok {
+				return nil, p.errorf("expected account reference after 'to'")
+			}
+			clauses = append(clauses, DestinationClause{Account: ref, Portion: portion / 100})
+		case p.peek().kind == tokenIdent && p.peek().text == "remaining":
+			p.next()
+			if err := p.expectIdent("to"); err != nil {
+				return nil, err
+			}
+			ref, ok := p.tryParseAccountRef()
+			if !ok {
+				return nil, p.errorf("expected account reference after 'to'")
+			}
+			clauses = append(clauses, DestinationClause{Account: ref, IsRemaining: true})
+		default:
+			return nil, p.errorf("expected a portion or 'remaining', got %q", p.peek().text)
+		}
+	}
+	p.next() // consume '}'
+
+	return clauses, nil
+}
+
+func (p *parser) tryParseAccountRef() (AccountRef, bool) {
+	t := p.peek()
+	switch t.kind {
+	case tokenAccountRef:
+		p.next()
+		return AccountRef{Literal: t.text}, true
+	case tokenVariableRef:
+		p.next()
+		return AccountRef{Variable: t.text}, true
+	default:
+		return AccountRef{}, false
+	}
+}
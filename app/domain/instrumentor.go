@@ -8,6 +8,9 @@ type Instrumentator interface {
 	Log(ctx context.Context, value string)
 	MonitorSegment(ctx context.Context) Segment
 	MonitorDataSegment(ctx context.Context, collection, operation, query string) Segment
+	// RecordGauge reports a point-in-time metric, such as the account
+	// filter's observed false-positive rate, identified by name.
+	RecordGauge(ctx context.Context, name string, value float64)
 }
 
 type Segment interface {
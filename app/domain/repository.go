@@ -1,5 +1,7 @@
 package domain
 
+//go:generate go run github.com/matryer/moq -out ../tests/mocks/repository.go -pkg mocks . Repository
+
 import (
 	"context"
 	"time"
@@ -10,9 +12,32 @@ import (
 )
 
 type Repository interface {
-	CreateTransaction(context.Context, entities.Transaction) error
-	GetAnalyticAccountBalance(context.Context, vos.Account) (vos.AccountBalance, error)
-	GetSyntheticAccountBalance(context.Context, vos.Account) (vos.AccountBalance, error)
-	GetSyntheticReport(context.Context, vos.Account, int, time.Time, time.Time) (*vos.SyntheticReport, error)
-	ListAccountEntries(context.Context, vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error)
+	// CreateTransaction persists the transaction, chaining every entry
+	// onto the previous entry of its account as described by the
+	// chainhash package. When entities.Transaction.IdempotencyKey has
+	// already been committed, the originally committed transaction is
+	// returned instead of inserting a duplicate; this must hold even when
+	// the same key is retried concurrently.
+	CreateTransaction(context.Context, entities.Transaction) (entities.Transaction, error)
+	// EnsureBucket creates the Postgres schema backing the named tenant
+	// bucket and runs its migrations, if it does not already exist. It is
+	// idempotent so it is safe to call on every request from an
+	// unrecognized bucket, not just from the migration CLI.
+	EnsureBucket(ctx context.Context, name string) error
+	// ListBuckets returns every bucket name known to the registry table,
+	// used by the `ledger buckets upgrade-all` command.
+	ListBuckets(ctx context.Context) ([]string, error)
+	// GetAnalyticAccountBalance and GetSyntheticAccountBalance return one
+	// vos.AccountBalance per asset the account has ever moved, since an
+	// account's entries are no longer guaranteed to share a single asset.
+	GetAnalyticAccountBalance(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error)
+	GetSyntheticAccountBalance(ctx context.Context, bucket string, account vos.Account) ([]vos.AccountBalance, error)
+	// GetSyntheticReport breaks its totals down by asset; see
+	// vos.SyntheticReport.
+	GetSyntheticReport(ctx context.Context, bucket string, account vos.Account, n int, from, to time.Time) (*vos.SyntheticReport, error)
+	ListAccountEntries(ctx context.Context, bucket string, req vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error)
+	// VerifyChain recomputes the hash chain of account's entries between
+	// from and to, returning one ChainVerification per entry in the
+	// range so callers can detect tampering anywhere in the chain.
+	VerifyChain(ctx context.Context, bucket string, account vos.Account, from, to time.Time) ([]ChainVerification, error)
 }
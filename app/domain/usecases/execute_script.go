@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/dsl"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+// ExecuteScriptRequest groups the input of ExecuteScript: the DSL source,
+// the variables it references, and whether it should only be planned
+// (DryRun) rather than persisted.
+type ExecuteScriptRequest struct {
+	Bucket         string
+	Script         string
+	Variables      map[string]string
+	DryRun         bool
+	IdempotencyKey uuid.UUID
+}
+
+// ExecuteScriptResponse carries the outcome of ExecuteScript. TransactionID
+// is the zero UUID when Request.DryRun is true, since nothing was
+// persisted.
+type ExecuteScriptResponse struct {
+	TransactionID uuid.UUID
+	Entries       []entities.Entry
+}
+
+// ExecuteScript parses and evaluates a DSL script, then persists the
+// resulting transaction unless req.DryRun is set, in which case only the
+// planned entries are returned.
+//
+// This is use-case-only: no HTTP or gRPC handler calls it yet, matching
+// every other use case in this package today. Exposing it over the wire
+// is tracked as separate follow-up work, not part of this change.
+func (u *LedgerUseCase) ExecuteScript(ctx context.Context, req ExecuteScriptRequest) (ExecuteScriptResponse, error) {
+	segment := u.instrumentator.MonitorSegment(ctx)
+	defer segment.End()
+
+	script, err := dsl.Parse(req.Script)
+	if err != nil {
+		return ExecuteScriptResponse{}, err
+	}
+
+	plan, err := dsl.NewEvaluator(u.repository).Evaluate(ctx, script, req.Variables, req.Bucket)
+	if err != nil {
+		return ExecuteScriptResponse{}, err
+	}
+
+	if req.DryRun {
+		return ExecuteScriptResponse{Entries: plan.Entries}, nil
+	}
+
+	transaction := dsl.Compile(plan, req.IdempotencyKey)
+	committed, err := u.repository.CreateTransaction(ctx, transaction)
+	if err != nil {
+		return ExecuteScriptResponse{}, err
+	}
+
+	return ExecuteScriptResponse{TransactionID: committed.ID, Entries: committed.Entries}, nil
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
@@ -22,13 +23,13 @@ func TestLedgerUseCase_ListAccountEntries(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockedRepository := &mocks.RepositoryMock{
-			ListAccountEntriesFunc: func(ctx context.Context, req vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
+			ListAccountEntriesFunc: func(ctx context.Context, bucket string, req vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
 				return []vos.AccountEntry{
 					{
 						ID:             uuid.New(),
 						Version:        vos.NextAccountVersion,
 						Operation:      vos.CreditOperation,
-						Amount:         100,
+						Amount:         big.NewInt(100),
 						Event:          1,
 						CompetenceDate: time.Now().Round(time.Nanosecond),
 						Metadata:       nil,
@@ -57,7 +58,7 @@ func TestLedgerUseCase_ListAccountEntries(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockedRepository := &mocks.RepositoryMock{
-			ListAccountEntriesFunc: func(ctx context.Context, req vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
+			ListAccountEntriesFunc: func(ctx context.Context, bucket string, req vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
 				return []vos.AccountEntry{}, nil, nil
 			},
 		}
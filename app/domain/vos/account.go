@@ -3,7 +3,7 @@ package vos
 import (
 	"strings"
 
-	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/apierrors"
 )
 
 // Account represents a countability account which, underneath, can be a single ledger account or a group of accounts.
@@ -33,6 +33,7 @@ import (
 type Account struct {
 	accountType AccountType
 	value       string
+	asset       Asset
 }
 
 func (a Account) Value() string {
@@ -43,6 +44,13 @@ func (a Account) Type() AccountType {
 	return a.accountType
 }
 
+// Asset returns the asset this account is denominated in: the asset
+// explicitly given to NewAccount/NewAnalyticAccount, or one inferred from
+// the account's class when none was given.
+func (a Account) Asset() Asset {
+	return a.asset
+}
+
 // AccountType indicates what the given account represents, being either analytic or a synthetic.
 type AccountType uint8
 
@@ -90,18 +98,29 @@ type state struct {
 }
 
 // NewAnalyticAccount creates a new valid Account, which can only be of analytic type.
-func NewAnalyticAccount(account string) (Account, error) {
-	return newAccount(account, true)
+// An asset may optionally be given to denominate the account in something
+// other than the asset inferred from its class (see inferAssetFromClass);
+// passing more than one asset is a programmer error and only the first is used.
+func NewAnalyticAccount(account string, asset ...Asset) (Account, error) {
+	return newAccount(account, true, firstAsset(asset))
+}
+
+// NewAccount creates a new valid Account. An asset may optionally be given,
+// see NewAnalyticAccount.
+func NewAccount(account string, asset ...Asset) (Account, error) {
+	return newAccount(account, false, firstAsset(asset))
 }
 
-// NewAccount creates a new valid Account.
-func NewAccount(account string) (Account, error) {
-	return newAccount(account, false)
+func firstAsset(assets []Asset) Asset {
+	if len(assets) > 0 {
+		return assets[0]
+	}
+	return Asset{}
 }
 
-func newAccount(account string, analyticOnly bool) (Account, error) {
+func newAccount(account string, analyticOnly bool, overrideAsset Asset) (Account, error) {
 	if len(account) == 0 {
-		return Account{}, app.ErrInvalidAccountStructure
+		return Account{}, apierrors.ErrInvalidAccountStructure
 	}
 
 	st := &state{strategy: Analytic}
@@ -125,12 +144,12 @@ func newAccount(account string, analyticOnly bool) (Account, error) {
 			err = treatDot(account, st)
 		case r == star:
 			if analyticOnly {
-				err = app.ErrInvalidSingleAccountComponentCharacters
+				err = apierrors.ErrInvalidSingleAccountComponentCharacters
 				break
 			}
 			err = treatStar(st)
 		default:
-			err = app.ErrInvalidAccountComponentCharacters
+			err = apierrors.ErrInvalidAccountComponentCharacters
 		}
 
 		if err != nil {
@@ -139,33 +158,43 @@ func newAccount(account string, analyticOnly bool) (Account, error) {
 	}
 
 	if r == dot {
-		return Account{}, app.ErrInvalidAccountComponentSize
+		return Account{}, apierrors.ErrInvalidAccountComponentSize
 	}
 
 	if st.totalComponents == 0 && !st.componentHasStar {
 		switch account[:st.componentSize] {
 		case asset, conciliateCredit, conciliateDebit, equity, expense, revenue, liability:
 		default:
-			return Account{}, app.ErrAccountPathViolation
+			return Account{}, apierrors.ErrAccountPathViolation
 		}
 	} else if st.totalComponents < 2 && st.strategy != Synthetic {
-		return Account{}, app.ErrInvalidAccountStructure
+		return Account{}, apierrors.ErrInvalidAccountStructure
 	}
 
 	if st.needsLower {
 		account = lowerAccount(account)
 	}
 
+	resolvedAsset := overrideAsset
+	if resolvedAsset.IsZero() {
+		class := account
+		if idx := strings.IndexRune(account, dot); idx >= 0 {
+			class = account[:idx]
+		}
+		resolvedAsset = inferAssetFromClass(class)
+	}
+
 	return Account{
 		value:       account,
 		accountType: st.strategy,
+		asset:       resolvedAsset,
 	}, nil
 }
 
 func treatDot(account string, st *state) error {
 	// Check if the current component is empty or greater than maximum.
 	if st.componentSize == 0 || st.componentSize > maxLabelSize {
-		return app.ErrInvalidAccountComponentSize
+		return apierrors.ErrInvalidAccountComponentSize
 	}
 
 	// Checks if the account has a valid class and if number of components is greater than maximum.
@@ -173,10 +202,10 @@ func treatDot(account string, st *state) error {
 		switch account[:st.componentSize] {
 		case asset, conciliateCredit, conciliateDebit, equity, expense, revenue, liability:
 		default:
-			return app.ErrAccountPathViolation
+			return apierrors.ErrAccountPathViolation
 		}
 	} else if st.totalComponents >= maxComponents {
-		return app.ErrInvalidAccountStructure
+		return apierrors.ErrInvalidAccountStructure
 	}
 
 	st.totalComponents += 1
@@ -189,7 +218,7 @@ func treatDot(account string, st *state) error {
 func treatStar(st *state) error {
 	// Checks is the current component already have a '*'.
 	if st.componentHasStar {
-		return app.ErrInvalidAccountStructure
+		return apierrors.ErrInvalidAccountStructure
 	}
 
 	st.strategy = Synthetic
@@ -0,0 +1,48 @@
+package vos
+
+import "github.com/stone-co/the-amazing-ledger/app"
+
+// Asset identifies the unit an amount is denominated in (e.g. BRL, USD,
+// BTC) together with the number of decimal places its minor unit
+// represents, so a raw integer amount can be formatted or compared
+// without silently mixing currencies or scales.
+type Asset struct {
+	code  string
+	scale uint8
+}
+
+// DefaultAsset is used by NewAccount/NewAnalyticAccount when no asset is
+// given, matching the ledger's original single-currency (BRL, cents)
+// behavior.
+var DefaultAsset = Asset{code: "BRL", scale: 2}
+
+func (a Asset) Code() string { return a.code }
+func (a Asset) Scale() uint8 { return a.scale }
+func (a Asset) IsZero() bool { return a.code == "" }
+
+// NewAsset validates and creates an Asset. code must be 1 to 10 uppercase
+// letters (e.g. "USD", "BTC"); scale is the number of decimal places its
+// minor unit represents (2 for BRL/USD cents, 8 for BTC satoshis).
+func NewAsset(code string, scale uint8) (Asset, error) {
+	if len(code) == 0 || len(code) > 10 {
+		return Asset{}, app.ErrInvalidAssetCode
+	}
+
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return Asset{}, app.ErrInvalidAssetCode
+		}
+	}
+
+	return Asset{code: code, scale: scale}, nil
+}
+
+// inferAssetFromClass returns the asset new accounts of class default to
+// when none is given explicitly. Every class defaults to DefaultAsset
+// today; class is accepted (rather than this simply being DefaultAsset
+// inlined at the call site) so a class that should default to a different
+// currency, e.g. a dedicated crypto ledger, has a single place to add that
+// override once one is needed.
+func inferAssetFromClass(class string) Asset {
+	return DefaultAsset
+}
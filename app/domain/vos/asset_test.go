@@ -0,0 +1,45 @@
+package vos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewAsset(t *testing.T) {
+	t.Run("should create a valid asset", func(t *testing.T) {
+		asset, err := vos.NewAsset("BTC", 8)
+		assert.NoError(t, err)
+		assert.Equal(t, "BTC", asset.Code())
+		assert.Equal(t, uint8(8), asset.Scale())
+	})
+
+	t.Run("should reject a lowercase code", func(t *testing.T) {
+		_, err := vos.NewAsset("btc", 8)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject an empty code", func(t *testing.T) {
+		_, err := vos.NewAsset("", 2)
+		assert.Error(t, err)
+	})
+}
+
+func TestAccount_Asset(t *testing.T) {
+	t.Run("should default to DefaultAsset when none is given", func(t *testing.T) {
+		account, err := vos.NewAnalyticAccount("asset.account.treasury")
+		assert.NoError(t, err)
+		assert.Equal(t, vos.DefaultAsset, account.Asset())
+	})
+
+	t.Run("should use the asset explicitly given to NewAnalyticAccount", func(t *testing.T) {
+		btc, err := vos.NewAsset("BTC", 8)
+		assert.NoError(t, err)
+
+		account, err := vos.NewAnalyticAccount("asset.account.treasury", btc)
+		assert.NoError(t, err)
+		assert.Equal(t, btc, account.Asset())
+	})
+}
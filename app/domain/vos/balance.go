@@ -0,0 +1,23 @@
+package vos
+
+import "math/big"
+
+// AccountBalance is an account's balance in a single asset. An account
+// that has moved more than one asset is represented as one AccountBalance
+// per asset, never as a single mixed-currency total.
+type AccountBalance struct {
+	Asset   Asset
+	Balance *big.Int
+}
+
+// AddBalance returns balance + amount as a new *big.Int, leaving both
+// arguments untouched.
+func AddBalance(balance, amount *big.Int) *big.Int {
+	return new(big.Int).Add(balance, amount)
+}
+
+// SubBalance returns balance - amount as a new *big.Int, leaving both
+// arguments untouched.
+func SubBalance(balance, amount *big.Int) *big.Int {
+	return new(big.Int).Sub(balance, amount)
+}
@@ -0,0 +1,68 @@
+// Package buckets wires the `ledger buckets` command group, letting an
+// operator run migrations against a single tenant bucket, or against
+// every bucket the registry table currently knows about, without
+// restarting the ledger process.
+package buckets
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+)
+
+// NewCommand builds the `buckets` command group, backed by repo.
+func NewCommand(repo domain.Repository) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buckets",
+		Short: "Manage per-tenant bucket schemas",
+	}
+
+	cmd.AddCommand(newUpgradeCommand(repo), newUpgradeAllCommand(repo))
+
+	return cmd
+}
+
+func newUpgradeCommand(repo domain.Repository) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upgrade <name>",
+		Short: "Create (if needed) and migrate a single bucket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := domain.NormalizeBucket(args[0])
+
+			if err := repo.EnsureBucket(cmd.Context(), name); err != nil {
+				return fmt.Errorf("upgrading bucket %q: %w", name, err)
+			}
+
+			cmd.Printf("bucket %q upgraded\n", name)
+			return nil
+		},
+	}
+}
+
+func newUpgradeAllCommand(repo domain.Repository) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upgrade-all",
+		Short: "Migrate every bucket listed in the registry table",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			names, err := repo.ListBuckets(ctx)
+			if err != nil {
+				return fmt.Errorf("listing buckets: %w", err)
+			}
+
+			for _, name := range names {
+				if err := repo.EnsureBucket(ctx, name); err != nil {
+					return fmt.Errorf("upgrading bucket %q: %w", name, err)
+				}
+				cmd.Printf("bucket %q upgraded\n", name)
+			}
+
+			return nil
+		},
+	}
+}
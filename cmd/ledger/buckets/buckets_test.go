@@ -0,0 +1,36 @@
+package buckets_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/cmd/ledger/buckets"
+)
+
+func TestUpgradeAllCommand(t *testing.T) {
+	t.Run("should upgrade every bucket returned by the registry", func(t *testing.T) {
+		var upgraded []string
+
+		repo := &mocks.RepositoryMock{
+			ListBucketsFunc: func(ctx context.Context) ([]string, error) {
+				return []string{"acme", "globex"}, nil
+			},
+			EnsureBucketFunc: func(ctx context.Context, name string) error {
+				upgraded = append(upgraded, name)
+				return nil
+			},
+		}
+
+		cmd := buckets.NewCommand(repo)
+		cmd.SetArgs([]string{"upgrade-all"})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, []string{"acme", "globex"}, upgraded)
+	})
+}